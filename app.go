@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"github.com/fatih/color"
 	"github.com/marmotedu/errors"
-	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	"os"
@@ -21,8 +20,7 @@ var (
   {{.NameAndAliases}}{{end}}{{if .HasExample}}
 %s
 {{.Example}}{{end}}{{if .HasAvailableSubCommands}}
-%s{{range .Commands}}{{if (or .IsAvailableCommand (eq .Name "help"))}}
-  %s {{.Short}}{{end}}{{end}}{{end}}{{if .HasAvailableLocalFlags}}
+{{groupedCommandsSection .}}{{end}}{{if .HasAvailableLocalFlags}}
 %s
 {{.LocalFlags.FlagUsages | trimTrailingWhitespaces}}{{end}}{{if .HasAvailableInheritedFlags}}
 %s
@@ -36,8 +34,6 @@ Use "%s --help" for more information about a command.{{end}}
 		color.GreenString("{{.CommandPath}} [command]"),
 		color.CyanString("Aliases:"),
 		color.CyanString("Examples:"),
-		color.CyanString("Available Commands:"),
-		color.GreenString("{{rpad .Name .NamePadding }}"),
 		color.CyanString("Flags:"),
 		color.CyanString("Global Flags:"),
 		color.CyanString("Additional help topics:"),
@@ -60,6 +56,12 @@ type App struct {
 	commands []*Command
 	args cobra.PositionalArgs
 	cmd *cobra.Command
+	versionInfo *VersionInfo
+	envPrefix string
+	cfgFile string
+	viper *viper.Viper
+	logger Logger
+	exitCoder func(error) int
 }
 
 
@@ -118,6 +120,14 @@ func WithNoConfig() Option {
 }
 
 
+// WithExitCoder sets the function MustRun uses to translate a run error
+// into a process exit code. If unset, MustRun always exits with 1.
+func WithExitCoder(coder func(error) int) Option {
+	return func(a *App) {
+		a.exitCoder = coder
+	}
+}
+
 // WithValidArgs set the validation function to valid non-flag arguments.
 func WithValidArgs(args cobra.PositionalArgs) Option {
 	return func(a *App) {
@@ -150,6 +160,12 @@ func NewApp(name, basename string, opts ...Option) *App {
 		opt(a)
 	}
 
+	if a.logger == nil {
+		a.logger = logrusLogger{}
+	}
+
+	a.viper = viper.New()
+
 	a.buildCommand()
 	return a
 }
@@ -165,7 +181,7 @@ func (a *App) buildCommand()  {
 		Args: a.args,
 	}
 
-	//cmd.SetUsageTemplate(usageTemplate)
+	cmd.SetUsageTemplate(usageTemplate)
 	cmd.SetOut(os.Stdout)
 	cmd.SetErr(os.Stderr)
 	cmd.Flags().SortFlags = true
@@ -185,45 +201,92 @@ func (a *App) buildCommand()  {
 	var namedFlagSets NamedFlagSets
 	if a.options != nil {
 		namedFlagSets = a.options.Flags()
-		fs := cmd.Flags()
-		for _, f := range namedFlagSets.FlagSets {
-			fs.AddFlagSet(f)
-
-		}
 
 		usageFmt := "Usage:\n  %s\n"
 		cols, _, _ := TerminalSize(cmd.OutOrStdout())
 		cmd.SetHelpFunc(func(cmd *cobra.Command, args []string) {
 			fmt.Fprintf(cmd.OutOrStdout(), "%s\n\n"+usageFmt, cmd.Long, cmd.UseLine())
+			if cmd.HasAvailableSubCommands() {
+				fmt.Fprintf(cmd.OutOrStdout(), "\n%s\n", groupedCommandsSection(cmd))
+			}
 			PrintSections(cmd.OutOrStdout(), namedFlagSets, cols)
 		})
 		cmd.SetUsageFunc(func(cmd *cobra.Command) error {
 			fmt.Fprintf(cmd.OutOrStderr(), usageFmt, cmd.UseLine())
+			if cmd.HasAvailableSubCommands() {
+				fmt.Fprintf(cmd.OutOrStderr(), "\n%s\n", groupedCommandsSection(cmd))
+			}
 			PrintSections(cmd.OutOrStderr(), namedFlagSets, cols)
 
 			return nil
 		})
-
 	}
 
-	if a.noVersion {
+	if !a.noVersion {
 		AddFlags(namedFlagSets.FlagSet("global"))
+		cmd.AddCommand(versionCommand(a))
 	}
 
 	if !a.noConfig {
-		addConfigFlag(a.basename, namedFlagSets.FlagSet("global"))
+		addConfigFlag(a, &cmd, namedFlagSets.FlagSet("global"))
 	}
 
 	AddGlobalFlags(namedFlagSets.FlagSet("global"), cmd.Name())
+	AddLogFlags(namedFlagSets.FlagSet("global"))
+	AddDebugFlag(namedFlagSets.FlagSet("global"))
+
+	// Copy every named flag set into cmd's own FlagSet now that all of them
+	// (including the global ones registered just above) have been populated.
+	// pflag's AddFlagSet only copies flags that exist at call time, so this
+	// must run after AddFlags/addConfigFlag/AddGlobalFlags/AddLogFlags/
+	// AddDebugFlag, not before them.
+	fs := cmd.Flags()
+	for _, f := range namedFlagSets.FlagSets {
+		fs.AddFlagSet(f)
+	}
+
+	cmd.AddCommand(completionCommand(a.basename))
+	markConfigFlagFilename(&cmd)
+
+	if completionOptions, ok := a.options.(CompletionOptions); ok {
+		if err := completionOptions.ApplyCompletion(&cmd); err != nil {
+			panic(err)
+		}
+	}
+
 	a.cmd = &cmd
 }
 
 
-// Run is used to launch the application
-func (a *App) Run()  {
+// Run executes the application and returns any error produced, instead of
+// exiting the process. Use MustRun for the previous os.Exit(1) behavior.
+func (a *App) Run() error {
 	if err := a.cmd.Execute(); err != nil {
-		fmt.Printf("%v %v\n", color.RedString("Error:", err))
-		os.Exit(1)
+		fmt.Printf("%v %v\n", color.RedString("Error:"), err)
+
+		if debugFlag {
+			if _, ok := err.(interface{ StackTrace() errors.StackTrace }); ok {
+				fmt.Printf("%+v\n", err)
+			}
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// MustRun executes the application and terminates the process if it
+// returns an error, translating the error to a process exit code via the
+// ExitCoder set with WithExitCoder (1, if none was set).
+func (a *App) MustRun() {
+	if err := a.Run(); err != nil {
+		code := 1
+		if a.exitCoder != nil {
+			code = a.exitCoder(err)
+		}
+
+		os.Exit(code)
 	}
 }
 
@@ -233,8 +296,9 @@ func (a *App) Command() *cobra.Command {
 }
 
 func (a *App) runCommand(cmd *cobra.Command, args []string) error {
-	printWorkDir()
-	PrintFlags(cmd.Flags())
+	applyLogFlags()
+	a.printWorkDir()
+	PrintFlags(a.logger, cmd.Flags())
 
 	if !a.noVersion {
 		// display application version information
@@ -242,22 +306,22 @@ func (a *App) runCommand(cmd *cobra.Command, args []string) error {
 	}
 
 	if !a.noConfig {
-		if err := viper.BindPFlags(cmd.Flags()); err != nil {
+		if err := a.viper.BindPFlags(cmd.Flags()); err != nil {
 			return err
 		}
 
-		if err := viper.Unmarshal(a.options); err != nil {
+		if err := a.viper.Unmarshal(a.options); err != nil {
 			return err
 		}
 	}
 
 	if !a.silence {
-		logrus.Infof("%v Starting %s ...", progressMessage, a.name)
+		a.logger.Infof("%v Starting %s ...", progressMessage, a.name)
 		if !a.noVersion {
-			logrus.Infof("%v Version: `%s`", progressMessage, Get())
+			a.logger.Infof("%v Version: `%s`", progressMessage, a.versionInfoOrDefault())
 		}
 		if !a.noConfig {
-			logrus.Infof("%v Config file used: `%s`", progressMessage, viper.ConfigFileUsed())
+			a.logger.Infof("%v Config file used: `%s`", progressMessage, a.viper.ConfigFileUsed())
 		}
 	}
 
@@ -286,13 +350,13 @@ func (a *App) applyOptionRules() error {
 	}
 
 	if printableOptions, ok := a.options.(PrintableOptions); ok {
-		logrus.Infof("%v Config: `%s`", progressMessage, printableOptions.String())
+		a.logger.Infof("%v Config: `%s`", progressMessage, printableOptions.String())
 	}
 
 	return nil
 }
 
-func printWorkDir()  {
+func (a *App) printWorkDir() {
 	wd, _ := os.Getwd()
-	logrus.Infof("%v WorkingDir: %s", progressMessage, wd)
+	a.logger.Infof("%v WorkingDir: %s", progressMessage, wd)
 }
\ No newline at end of file
@@ -0,0 +1,114 @@
+package create_app
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"os"
+	"strings"
+	"testing"
+)
+
+type noopOptions struct{}
+
+func (*noopOptions) Flags() (fss NamedFlagSets) { return }
+func (*noopOptions) Validate() []error          { return nil }
+
+// TestGlobalFlagsParse guards against the global flags added by AddFlags,
+// addConfigFlag, AddGlobalFlags, AddLogFlags, and AddDebugFlag only being
+// copied into cmd.Flags() before they were registered, which makes cobra
+// reject them as unknown.
+func TestGlobalFlagsParse(t *testing.T) {
+	a := NewApp("testapp", "testapp",
+		WithOptions(&noopOptions{}),
+		WithRunFunc(func(basename string) error { return nil }),
+	)
+
+	a.Command().SetArgs([]string{"--debug", "--log-level=debug", "--log-format=json", "--version=false"})
+
+	if err := a.Run(); err != nil {
+		t.Fatalf("Run() with global flags set = %v, want nil", err)
+	}
+}
+
+// TestRunPrintsUnderlyingError guards against the fmt.Printf misuse where
+// color.RedString("Error:", err) swallowed err as a format argument instead
+// of printing it.
+func TestRunPrintsUnderlyingError(t *testing.T) {
+	a := NewApp("testapp", "testapp",
+		WithOptions(&noopOptions{}),
+		WithRunFunc(func(basename string) error { return errors.New("boom") }),
+	)
+	a.Command().SetArgs(nil)
+
+	out := captureStdout(t, func() {
+		err := a.Run()
+		if err == nil || err.Error() != "boom" {
+			t.Fatalf("Run() error = %v, want %q", err, "boom")
+		}
+	})
+
+	if !strings.Contains(out, "boom") {
+		t.Fatalf("Run() stdout = %q, want it to contain the error message", out)
+	}
+
+	if strings.Contains(out, "%!") {
+		t.Fatalf("Run() stdout = %q, contains an unresolved fmt verb", out)
+	}
+}
+
+// TestMustRunUsesExitCoder guards the WithExitCoder wiring by repeating,
+// inline, the exit-code selection MustRun performs on the coder stored on
+// the App. It does not call MustRun itself, since that would os.Exit the
+// test binary.
+func TestMustRunUsesExitCoder(t *testing.T) {
+	var gotCode int
+	a := NewApp("testapp", "testapp",
+		WithOptions(&noopOptions{}),
+		WithExitCoder(func(err error) int {
+			gotCode = 7
+			return gotCode
+		}),
+		WithRunFunc(func(basename string) error { return errors.New("boom") }),
+	)
+	a.Command().SetArgs(nil)
+
+	err := a.Run()
+	if err == nil {
+		t.Fatal("Run() error = nil, want non-nil")
+	}
+
+	code := 1
+	if a.exitCoder != nil {
+		code = a.exitCoder(err)
+	}
+
+	if code != 7 {
+		t.Errorf("exit code = %d, want 7", code)
+	}
+}
+
+// captureStdout runs fn with os.Stdout redirected to a pipe and returns
+// everything written to it.
+func captureStdout(t *testing.T, fn func()) string {
+	t.Helper()
+
+	old := os.Stdout
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("os.Pipe() error = %v", err)
+	}
+	os.Stdout = w
+
+	fn()
+
+	w.Close()
+	os.Stdout = old
+
+	var buf bytes.Buffer
+	if _, err := io.Copy(&buf, r); err != nil {
+		t.Fatalf("io.Copy() error = %v", err)
+	}
+
+	return buf.String()
+}
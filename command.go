@@ -0,0 +1,183 @@
+package create_app
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+// commandGroupAnnotation stores a Command's group under a cobra.Command's
+// Annotations, so the usage template can split "Available Commands:" by
+// group heading without threading an extra field through cobra.
+const commandGroupAnnotation = "create-app:command-group"
+
+func init() {
+	cobra.AddTemplateFunc("groupedCommandsSection", groupedCommandsSection)
+}
+
+// CommandOption defines optional parameters for initializing a Command.
+type CommandOption func(*Command)
+
+// Command is a fluent builder for a cobra sub-command, built with
+// NewCommand and rendered into a *cobra.Command by cobraCommand.
+type Command struct {
+	usage       string
+	desc        string
+	group       string
+	options     CliOptions
+	commands    []*Command
+	runFunc     RunFunc
+	middlewares []func(RunFunc) RunFunc
+}
+
+// NewCommand creates a Command with the given name and short description.
+func NewCommand(name, short string, opts ...CommandOption) *Command {
+	c := &Command{
+		usage: name,
+		desc:  short,
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c
+}
+
+// WithCommands registers the given commands as this App's top-level
+// sub-commands.
+func WithCommands(commands ...*Command) Option {
+	return func(a *App) {
+		a.commands = append(a.commands, commands...)
+	}
+}
+
+// WithSubCommands registers the given commands as children of this Command.
+func WithSubCommands(commands ...*Command) CommandOption {
+	return func(c *Command) {
+		c.commands = append(c.commands, commands...)
+	}
+}
+
+// WithCommandRunFunc sets the callback invoked when this Command runs.
+func WithCommandRunFunc(run RunFunc) CommandOption {
+	return func(c *Command) {
+		c.runFunc = run
+	}
+}
+
+// WithCommandOptions attaches flag-backed options to this Command.
+func WithCommandOptions(opt CliOptions) CommandOption {
+	return func(c *Command) {
+		c.options = opt
+	}
+}
+
+// WithCommandGroup assigns this Command to a named group, so it is listed
+// under its own heading rather than the default "Available Commands:"
+// section in `--help` output.
+func WithCommandGroup(group string) CommandOption {
+	return func(c *Command) {
+		c.group = group
+	}
+}
+
+// WithMiddleware wraps this Command's run func, letting callers layer
+// cross-cutting concerns (auth, tracing, metrics) around it. Middlewares
+// registered later run closer to the final run func, the same order
+// `net/http` middleware chains compose in.
+func WithMiddleware(mw func(next RunFunc) RunFunc) CommandOption {
+	return func(c *Command) {
+		c.middlewares = append(c.middlewares, mw)
+	}
+}
+
+// cobraCommand renders the Command tree into a *cobra.Command.
+func (c *Command) cobraCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   c.usage,
+		Short: c.desc,
+	}
+
+	if c.group != "" {
+		cmd.Annotations = map[string]string{commandGroupAnnotation: c.group}
+	}
+
+	for _, sub := range c.commands {
+		cmd.AddCommand(sub.cobraCommand())
+	}
+
+	if c.options != nil {
+		fs := cmd.Flags()
+		for _, f := range c.options.Flags().FlagSets {
+			fs.AddFlagSet(f)
+		}
+	}
+
+	if c.runFunc != nil {
+		run := c.runFunc
+		for i := len(c.middlewares) - 1; i >= 0; i-- {
+			run = c.middlewares[i](run)
+		}
+
+		cmd.RunE = func(cmd *cobra.Command, args []string) error {
+			return run(cmd.Name())
+		}
+	}
+
+	return cmd
+}
+
+const defaultCommandGroup = "Available Commands"
+
+// commandGroup pairs a group heading with the commands registered under it.
+type commandGroup struct {
+	name     string
+	commands []*cobra.Command
+}
+
+// groupedCommandsSection renders cmd's available sub-commands, split into
+// one section per WithCommandGroup heading, with ungrouped commands
+// collected under the default "Available Commands:" heading. Group order
+// follows first appearance among cmd's sub-commands.
+func groupedCommandsSection(cmd *cobra.Command) string {
+	order := make([]string, 0)
+	groups := make(map[string][]*cobra.Command)
+
+	for _, sub := range cmd.Commands() {
+		if !sub.IsAvailableCommand() && sub.Name() != "help" {
+			continue
+		}
+
+		group := sub.Annotations[commandGroupAnnotation]
+		if group == "" {
+			group = defaultCommandGroup
+		}
+
+		if _, ok := groups[group]; !ok {
+			order = append(order, group)
+		}
+		groups[group] = append(groups[group], sub)
+	}
+
+	var b strings.Builder
+	for i, name := range order {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+
+		fmt.Fprintf(&b, "%s\n", color.CyanString(name+":"))
+		for _, sub := range groups[name] {
+			fmt.Fprintf(&b, "  %s %s\n", color.GreenString(rpad(sub.Name(), sub.NamePadding())), sub.Short)
+		}
+	}
+
+	return strings.TrimRight(b.String(), "\n")
+}
+
+// rpad right-pads s with spaces out to padding characters wide.
+func rpad(s string, padding int) string {
+	return fmt.Sprintf(fmt.Sprintf("%%-%ds", padding), s)
+}
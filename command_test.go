@@ -0,0 +1,71 @@
+package create_app
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestGroupedCommandsSectionGroupsByHeading(t *testing.T) {
+	ungrouped := NewCommand("ungrouped", "no group", WithCommandRunFunc(func(string) error { return nil }))
+	grouped := NewCommand("grouped", "has group",
+		WithCommandGroup("Management Commands"),
+		WithCommandRunFunc(func(string) error { return nil }),
+	)
+
+	a := NewApp("testapp", "testapp", WithNoVersion(), WithNoConfig(), WithCommands(ungrouped, grouped))
+
+	out := groupedCommandsSection(a.Command())
+
+	if !strings.Contains(out, defaultCommandGroup+":") {
+		t.Errorf("output = %q, want it to contain the default group heading", out)
+	}
+	if !strings.Contains(out, "Management Commands:") {
+		t.Errorf("output = %q, want it to contain the custom group heading", out)
+	}
+	if !strings.Contains(out, "ungrouped") {
+		t.Errorf("output = %q, want it to list the ungrouped command", out)
+	}
+	if !strings.Contains(out, "grouped") {
+		t.Errorf("output = %q, want it to list the grouped command", out)
+	}
+
+	defaultIdx := strings.Index(out, defaultCommandGroup+":")
+	managementIdx := strings.Index(out, "Management Commands:")
+	if defaultIdx == -1 || managementIdx == -1 || defaultIdx > managementIdx {
+		t.Errorf("expected %q before %q since the ungrouped completion command sorts first, got %q",
+			defaultCommandGroup, "Management Commands", out)
+	}
+}
+
+func TestWithMiddlewareOrdering(t *testing.T) {
+	var calls []string
+
+	mw := func(name string) func(RunFunc) RunFunc {
+		return func(next RunFunc) RunFunc {
+			return func(basename string) error {
+				calls = append(calls, name)
+				return next(basename)
+			}
+		}
+	}
+
+	cmd := NewCommand("demo", "demo command",
+		WithCommandRunFunc(func(basename string) error {
+			calls = append(calls, "run")
+			return nil
+		}),
+		WithMiddleware(mw("outer")),
+		WithMiddleware(mw("inner")),
+	)
+
+	cobraCmd := cmd.cobraCommand()
+	if err := cobraCmd.RunE(cobraCmd, nil); err != nil {
+		t.Fatalf("RunE() error = %v", err)
+	}
+
+	want := []string{"outer", "inner", "run"}
+	if !reflect.DeepEqual(calls, want) {
+		t.Errorf("call order = %v, want %v (outer registered first should run outermost)", calls, want)
+	}
+}
@@ -0,0 +1,83 @@
+package create_app
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+)
+
+const completionLong = `Generate the shell completion script for %[1]s.
+
+This command outputs a completion script for the requested shell. See the
+examples below for how to load it into bash, zsh, fish, or PowerShell.
+
+Bash:
+  $ source <(%[1]s completion bash)
+
+  # To load completions for each session, execute once:
+  # Linux:
+  $ %[1]s completion bash > /etc/bash_completion.d/%[1]s
+  # macOS:
+  $ %[1]s completion bash > /usr/local/etc/bash_completion.d/%[1]s
+
+Zsh:
+  # If shell completion is not already enabled in your environment you will
+  # need to enable it once:
+  $ echo "autoload -U compinit; compinit" >> ~/.zshrc
+
+  $ %[1]s completion zsh > "${fpath[1]}/_%[1]s"
+
+  # You will need to start a new shell for this setup to take effect.
+
+Fish:
+  $ %[1]s completion fish | source
+
+  # To load completions for each session, execute once:
+  $ %[1]s completion fish > ~/.config/fish/completions/%[1]s.fish
+
+PowerShell:
+  PS> %[1]s completion powershell | Out-String | Invoke-Expression
+
+  # To load completions for every new session, run:
+  PS> %[1]s completion powershell > %[1]s.ps1
+  # and source this file from your PowerShell profile.
+`
+
+// completionCommand returns the "completion" sub-command, which emits a
+// shell completion script for the requested shell on stdout.
+func completionCommand(basename string) *cobra.Command {
+	return &cobra.Command{
+		Use:                   "completion [bash|zsh|fish|powershell]",
+		Short:                 "Generate shell completion scripts",
+		Long:                  fmt.Sprintf(completionLong, basename),
+		DisableFlagsInUseLine: true,
+		ValidArgs:             []string{"bash", "zsh", "fish", "powershell"},
+		Args:                  cobra.ExactValidArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			root := cmd.Root()
+			switch args[0] {
+			case "bash":
+				return root.GenBashCompletion(os.Stdout)
+			case "zsh":
+				return root.GenZshCompletion(os.Stdout)
+			case "fish":
+				return root.GenFishCompletion(os.Stdout, true)
+			case "powershell":
+				return root.GenPowerShellCompletionWithDesc(os.Stdout)
+			}
+
+			return nil
+		},
+	}
+}
+
+// markConfigFlagFilename registers filename completion for the --config flag
+// when cmd has one, so shells suggest config files rather than arbitrary paths.
+func markConfigFlagFilename(cmd *cobra.Command) {
+	if cmd.Flags().Lookup("config") == nil {
+		return
+	}
+
+	_ = cmd.MarkFlagFilename("config", "yaml", "yml", "json", "toml")
+}
@@ -0,0 +1,66 @@
+package create_app
+
+import (
+	"testing"
+
+	"github.com/spf13/cobra"
+)
+
+type completionAwareOptions struct {
+	applyCompletionCalled bool
+}
+
+func (*completionAwareOptions) Flags() (fss NamedFlagSets) { return }
+func (*completionAwareOptions) Validate() []error          { return nil }
+
+func (o *completionAwareOptions) ApplyCompletion(cmd *cobra.Command) error {
+	o.applyCompletionCalled = true
+	return nil
+}
+
+func TestBuildCommandAppliesCompletion(t *testing.T) {
+	opts := &completionAwareOptions{}
+	NewApp("testapp", "testapp", WithOptions(opts))
+
+	if !opts.applyCompletionCalled {
+		t.Error("ApplyCompletion was not called while building the command")
+	}
+}
+
+func TestCompletionCommandGeneratesScripts(t *testing.T) {
+	for _, shell := range []string{"bash", "zsh", "fish", "powershell"} {
+		shell := shell
+		t.Run(shell, func(t *testing.T) {
+			cmd := completionCommand("testapp")
+			cmd.SetArgs([]string{shell})
+
+			out := captureStdout(t, func() {
+				if err := cmd.Execute(); err != nil {
+					t.Fatalf("completion %s: Execute() error = %v", shell, err)
+				}
+			})
+
+			if out == "" {
+				t.Errorf("completion %s produced no output", shell)
+			}
+		})
+	}
+}
+
+func TestMarkConfigFlagFilenameNoopsWithoutConfigFlag(t *testing.T) {
+	cmd := &cobra.Command{Use: "test"}
+
+	markConfigFlagFilename(cmd) // must not panic
+}
+
+func TestMarkConfigFlagFilenameRegistersCompletion(t *testing.T) {
+	cmd := &cobra.Command{Use: "test"}
+	cmd.Flags().String("config", "", "")
+
+	markConfigFlagFilename(cmd)
+
+	annotations := cmd.Flags().Lookup("config").Annotations
+	if len(annotations[cobra.BashCompFilenameExt]) == 0 {
+		t.Fatal("markConfigFlagFilename did not register filename completion for --config")
+	}
+}
@@ -0,0 +1,115 @@
+package create_app
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+)
+
+const configFlagName = "config"
+
+// WithEnvPrefix sets the prefix viper uses when binding environment
+// variables, e.g. WithEnvPrefix("MYAPP") makes a "log-level" flag bindable
+// via the MYAPP_LOG_LEVEL environment variable. If unset, the app's
+// basename is used as the prefix.
+func WithEnvPrefix(prefix string) Option {
+	return func(a *App) {
+		a.envPrefix = prefix
+	}
+}
+
+// addConfigFlag adds the --config flag used to point at a configuration
+// file, and wires up auto-discovery, environment variable binding, and
+// hot-reload for the config machinery used by App.runCommand.
+//
+// Discovery runs from cmd's PersistentPreRunE, scoped to a's own
+// *viper.Viper, rather than cobra.OnInitialize and the viper package
+// singleton: cobra.OnInitialize appends to a process-wide list that every
+// command execution runs in full, and the viper package functions all
+// operate on one shared global instance, so constructing a second App in
+// the same process would otherwise silently stomp on the first one's
+// config discovery and hot-reload wiring.
+func addConfigFlag(a *App, cmd *cobra.Command, fs *pflag.FlagSet) {
+	basename := a.basename
+
+	fs.StringVar(&a.cfgFile, configFlagName, a.cfgFile, fmt.Sprintf(
+		"Read configuration from the specified file. Supports JSON, TOML, and YAML formats.\n"+
+			"If not specified, %s searches, in order:\n"+
+			"  ./%s.{yaml,json,toml}\n"+
+			"  $HOME/.%s/%s.{yaml,json,toml}\n"+
+			"  /etc/%s/%s.{yaml,json,toml}\n",
+		basename, basename, basename, basename, basename, basename))
+
+	envPrefix := a.envPrefix
+	if envPrefix == "" {
+		envPrefix = basename
+	}
+
+	a.viper.SetEnvPrefix(strings.ToUpper(normalize(envPrefix)))
+	a.viper.SetEnvKeyReplacer(strings.NewReplacer("-", "_", ".", "_"))
+	a.viper.AutomaticEnv()
+
+	previousPreRun := cmd.PersistentPreRunE
+	cmd.PersistentPreRunE = func(cmd *cobra.Command, args []string) error {
+		if previousPreRun != nil {
+			if err := previousPreRun(cmd, args); err != nil {
+				return err
+			}
+		}
+
+		found, err := a.initConfig(basename)
+		if err != nil {
+			return err
+		}
+
+		// Only watch once a config file was actually found: viper.WatchConfig
+		// logs a spurious "error: ..." line via the stdlib log package if it
+		// can't resolve a file to watch.
+		if !found {
+			return nil
+		}
+
+		a.viper.OnConfigChange(func(in fsnotify.Event) {
+			a.logger.Infof("%v Config file changed: %s", progressMessage, in.Name)
+
+			if reloadable, ok := a.options.(ReloadableOptions); ok {
+				if err := reloadable.Reload(a.viper); err != nil {
+					a.logger.Errorf("%v Failed to reload config: %v", progressMessage, err)
+				}
+			}
+		})
+		a.viper.WatchConfig()
+
+		return nil
+	}
+}
+
+// initConfig resolves the configuration file to read: the explicit --config
+// path if one was given, or else the first of ./, $HOME/.<basename>/, and
+// /etc/<basename>/ that contains a <basename>.{yaml,json,toml} file. found
+// reports whether a config file was actually located.
+func (a *App) initConfig(basename string) (found bool, err error) {
+	if a.cfgFile != "" {
+		a.viper.SetConfigFile(a.cfgFile)
+	} else {
+		a.viper.AddConfigPath(".")
+		a.viper.AddConfigPath(filepath.Join(HomeDir(), "."+basename))
+		a.viper.AddConfigPath(filepath.Join("/etc", basename))
+		a.viper.SetConfigName(basename)
+	}
+
+	if err := a.viper.ReadInConfig(); err != nil {
+		if _, ok := err.(viper.ConfigFileNotFoundError); ok && a.cfgFile == "" {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	return true, nil
+}
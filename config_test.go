@@ -0,0 +1,111 @@
+package create_app
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/viper"
+)
+
+func newTestApp() *App {
+	return &App{viper: viper.New(), logger: NopLogger{}}
+}
+
+func TestInitConfigNotFound(t *testing.T) {
+	dir := t.TempDir()
+	restore := chdir(t, dir)
+	defer restore()
+
+	a := newTestApp()
+
+	found, err := a.initConfig("nonexistent-app")
+	if err != nil {
+		t.Fatalf("initConfig() error = %v, want nil", err)
+	}
+
+	if found {
+		t.Error("initConfig() found = true, want false when no config file exists")
+	}
+}
+
+func TestInitConfigSearchesCurrentDir(t *testing.T) {
+	dir := t.TempDir()
+	restore := chdir(t, dir)
+	defer restore()
+
+	if err := os.WriteFile(filepath.Join(dir, "myapp.yaml"), []byte("name: alice\n"), 0o644); err != nil {
+		t.Fatalf("failed to write config fixture: %v", err)
+	}
+
+	a := newTestApp()
+
+	found, err := a.initConfig("myapp")
+	if err != nil {
+		t.Fatalf("initConfig() error = %v, want nil", err)
+	}
+
+	if !found {
+		t.Fatal("initConfig() found = false, want true when ./myapp.yaml exists")
+	}
+
+	if got := a.viper.GetString("name"); got != "alice" {
+		t.Errorf("a.viper.GetString(%q) = %q, want %q", "name", got, "alice")
+	}
+}
+
+// TestInitConfigIsScopedPerApp guards against falling back to
+// cobra.OnInitialize and viper's global singleton: constructing a second
+// App and resolving its config must not affect a first, already
+// configured one.
+func TestInitConfigIsScopedPerApp(t *testing.T) {
+	dir := t.TempDir()
+	restore := chdir(t, dir)
+	defer restore()
+
+	if err := os.WriteFile(filepath.Join(dir, "appone.yaml"), []byte("name: one\n"), 0o644); err != nil {
+		t.Fatalf("failed to write config fixture: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "apptwo.yaml"), []byte("name: two\n"), 0o644); err != nil {
+		t.Fatalf("failed to write config fixture: %v", err)
+	}
+
+	a1 := newTestApp()
+	if _, err := a1.initConfig("appone"); err != nil {
+		t.Fatalf("a1.initConfig() error = %v", err)
+	}
+
+	a2 := newTestApp()
+	if _, err := a2.initConfig("apptwo"); err != nil {
+		t.Fatalf("a2.initConfig() error = %v", err)
+	}
+
+	if got := a1.viper.GetString("name"); got != "one" {
+		t.Errorf("a1.viper.GetString(%q) = %q, want %q (second App must not clobber the first)", "name", got, "one")
+	}
+
+	if got := a2.viper.GetString("name"); got != "two" {
+		t.Errorf("a2.viper.GetString(%q) = %q, want %q", "name", got, "two")
+	}
+}
+
+// chdir switches the process working directory to dir and returns a func
+// that restores the previous one.
+func chdir(t *testing.T, dir string) func() {
+	t.Helper()
+
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatalf("os.Getwd() error = %v", err)
+	}
+
+	if err := os.Chdir(dir); err != nil {
+		t.Fatalf("os.Chdir(%q) error = %v", dir, err)
+	}
+
+	return func() {
+		if err := os.Chdir(wd); err != nil {
+			t.Fatalf("os.Chdir(%q) error = %v", wd, err)
+		}
+	}
+}
@@ -0,0 +1,13 @@
+package create_app
+
+import "github.com/spf13/pflag"
+
+const flagDebug = "debug"
+
+var debugFlag bool
+
+// AddDebugFlag registers the --debug flag used to enable stack-trace output
+// for errors.WithStack-wrapped errors when App.Run fails.
+func AddDebugFlag(fs *pflag.FlagSet) {
+	fs.BoolVar(&debugFlag, flagDebug, false, "Print a stack trace alongside the error, if one is available.")
+}
@@ -2,7 +2,6 @@ package create_app
 
 import (
 	goflag "flag"
-	"github.com/sirupsen/logrus"
 	"github.com/spf13/pflag"
 	"strings"
 )
@@ -28,8 +27,8 @@ func InitFlags(flags *pflag.FlagSet)  {
 	flags.AddGoFlagSet(goflag.CommandLine)
 }
 
-func PrintFlags(flags *pflag.FlagSet)  {
+func PrintFlags(logger Logger, flags *pflag.FlagSet)  {
 	flags.VisitAll(func(flag *pflag.Flag) {
-		logrus.Debugf("FLAG: --%s=%q", flag.Name, flag.Value)
+		logger.Debugf("FLAG: --%s=%q", flag.Name, flag.Value)
 	})
 }
\ No newline at end of file
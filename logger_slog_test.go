@@ -0,0 +1,31 @@
+//go:build go1.21
+
+package create_app
+
+import (
+	"bytes"
+	"log/slog"
+	"strings"
+	"testing"
+)
+
+func TestSlogLoggerFormatsMessage(t *testing.T) {
+	var buf bytes.Buffer
+	handler := slog.NewTextHandler(&buf, nil)
+	logger := NewSlogLogger(slog.New(handler))
+
+	logger.Infof("hello %s, count=%d", "world", 3)
+
+	out := buf.String()
+	if !strings.Contains(out, "hello world, count=3") {
+		t.Errorf("slog output = %q, want it to contain the formatted message", out)
+	}
+
+	if !strings.Contains(out, "level=INFO") {
+		t.Errorf("slog output = %q, want level=INFO", out)
+	}
+}
+
+func TestNewSlogLoggerDefaultsWhenNil(t *testing.T) {
+	var _ Logger = NewSlogLogger(nil)
+}
@@ -0,0 +1,17 @@
+package create_app
+
+import "testing"
+
+func TestNopLoggerDiscardsEverything(t *testing.T) {
+	var l Logger = NopLogger{}
+
+	// These must not panic, and there's nothing else to observe.
+	l.Infof("%s", "info")
+	l.Debugf("%s", "debug")
+	l.Warnf("%s", "warn")
+	l.Errorf("%s", "error")
+}
+
+func TestLogrusLoggerImplementsLogger(t *testing.T) {
+	var _ Logger = logrusLogger{}
+}
@@ -3,7 +3,9 @@ package create_app
 import (
 	"bytes"
 	"fmt"
+	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
 	"io"
 	"strings"
 )
@@ -92,4 +94,22 @@ type CompletableOptions interface {
 // PrintableOptions abstracts options which can be printed.
 type PrintableOptions interface {
 	String() string
+}
+
+// CompletionOptions abstracts options that want to register their own shell
+// completion behavior, e.g. an enum-valued flag that should only complete
+// from a fixed set of values.
+type CompletionOptions interface {
+	// ApplyCompletion registers ValidArgsFunction completions for the flags
+	// owned by this option group against the root command.
+	ApplyCompletion(cmd *cobra.Command) error
+}
+
+// ReloadableOptions abstracts options that want to react to config file
+// changes picked up via viper.WatchConfig, e.g. a long-running daemon that
+// should pick up new values without a restart.
+type ReloadableOptions interface {
+	// Reload re-applies the freshly-read configuration in v to the option
+	// group.
+	Reload(v *viper.Viper) error
 }
\ No newline at end of file
@@ -100,6 +100,18 @@ func HomeDir() string {
 	return ""
 }
 
+// FormatBasename makes the basename suitable for use as the root command's
+// Use line: on Windows it lower-cases it and strips a trailing ".exe" so
+// "MyApp.EXE" and "myapp" resolve to the same command name.
+func FormatBasename(basename string) string {
+	if runtime.GOOS == "windows" {
+		basename = strings.ToLower(basename)
+		basename = strings.TrimSuffix(basename, ".exe")
+	}
+
+	return basename
+}
+
 // AddGlobalFlags explicitly registers flags that libraries (log, verflag, etc.) register
 // against the global flagsets from "flag".
 // We do this in order to prevent unwanted flags from leaking into the component's flagset.
@@ -0,0 +1,95 @@
+package create_app
+
+import (
+	"fmt"
+	"os"
+
+	"create-app/version"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// VersionInfo is the build metadata reported by the "version" sub-command
+// and the --version flag.
+type VersionInfo = version.Info
+
+// Get returns the default build metadata, populated at link time via
+// -ldflags -X variables in the version package.
+func Get() VersionInfo {
+	return version.Get()
+}
+
+// WithVersionInfo overrides the build metadata the "version" sub-command
+// and the startup log report, letting downstream binaries set it
+// programmatically instead of relying solely on link-time ldflags. The
+// --version flag is not affected: PrintAndExitIfRequested always prints
+// the link-time metadata from Get.
+func WithVersionInfo(v VersionInfo) Option {
+	return func(a *App) {
+		a.versionInfo = &v
+	}
+}
+
+// versionInfoOrDefault returns the version info set via WithVersionInfo, or
+// the link-time default if none was set.
+func (a *App) versionInfoOrDefault() VersionInfo {
+	if a.versionInfo != nil {
+		return *a.versionInfo
+	}
+
+	return Get()
+}
+
+const flagVersion = "version"
+
+var printVersion bool
+
+// AddFlags registers the shorthand --version flag that, combined with
+// PrintAndExitIfRequested, lets a binary report its version without going
+// through the dedicated "version" sub-command.
+func AddFlags(fs *pflag.FlagSet) {
+	fs.BoolVar(&printVersion, flagVersion, false, "Print version information and quit.")
+}
+
+// PrintAndExitIfRequested prints the link-time version information from
+// Get and exits the process with status 0 if --version was passed on the
+// command line. Metadata set with WithVersionInfo is not used here.
+func PrintAndExitIfRequested() {
+	if printVersion {
+		fmt.Println(Get().Text())
+		os.Exit(0)
+	}
+}
+
+// versionCommand builds the "version" sub-command, printing a's build
+// metadata in text, JSON, YAML, or short form.
+func versionCommand(a *App) *cobra.Command {
+	var output string
+
+	cmd := &cobra.Command{
+		Use:   "version",
+		Short: "Print the version information",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			info := a.versionInfoOrDefault()
+
+			switch output {
+			case "", "text":
+				fmt.Fprint(cmd.OutOrStdout(), info.Text())
+			case "short":
+				fmt.Fprintln(cmd.OutOrStdout(), info.GitVersion)
+			case "json":
+				fmt.Fprintln(cmd.OutOrStdout(), info.ToJSON())
+			case "yaml":
+				fmt.Fprint(cmd.OutOrStdout(), info.ToYAML())
+			default:
+				return fmt.Errorf("invalid --output %q, must be one of: text|json|yaml|short", output)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&output, "output", "o", "text", "Output format, one of: text|json|yaml|short.")
+
+	return cmd
+}
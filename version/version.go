@@ -0,0 +1,80 @@
+// Package version holds build metadata for the generated CLI binary. The
+// exported vars are meant to be overridden at link time via:
+//
+//	-ldflags "-X create-app/version.GitVersion=... -X create-app/version.GitCommit=..."
+package version
+
+import (
+	"encoding/json"
+	"fmt"
+	"runtime"
+
+	"gopkg.in/yaml.v2"
+)
+
+var (
+	// GitVersion is the semantic version of the build, e.g. "v1.2.3".
+	GitVersion = "v0.0.0-master+$Format:%H$"
+
+	// GitCommit is the git sha1 of the build.
+	GitCommit = ""
+
+	// GitTreeState is "clean" or "dirty", depending on whether the build
+	// was made from a modified working tree.
+	GitTreeState = ""
+
+	// BuildDate is the RFC3339 timestamp of the build.
+	BuildDate = "1970-01-01T00:00:00Z"
+)
+
+// Info describes the build metadata of the running binary.
+type Info struct {
+	GitVersion   string `json:"gitVersion" yaml:"gitVersion"`
+	GitCommit    string `json:"gitCommit" yaml:"gitCommit"`
+	GitTreeState string `json:"gitTreeState" yaml:"gitTreeState"`
+	BuildDate    string `json:"buildDate" yaml:"buildDate"`
+	GoVersion    string `json:"goVersion" yaml:"goVersion"`
+	Compiler     string `json:"compiler" yaml:"compiler"`
+	Platform     string `json:"platform" yaml:"platform"`
+}
+
+// String returns the semantic version, the same value "short" output uses.
+func (info Info) String() string {
+	return info.GitVersion
+}
+
+// Text renders the Info as a human-readable, multi-line block.
+func (info Info) Text() string {
+	return fmt.Sprintf(
+		"gitVersion: %s\ngitCommit: %s\ngitTreeState: %s\nbuildDate: %s\ngoVersion: %s\ncompiler: %s\nplatform: %s\n",
+		info.GitVersion, info.GitCommit, info.GitTreeState, info.BuildDate, info.GoVersion, info.Compiler, info.Platform,
+	)
+}
+
+// ToJSON renders the Info as indented JSON.
+func (info Info) ToJSON() string {
+	b, _ := json.MarshalIndent(info, "", "  ")
+
+	return string(b)
+}
+
+// ToYAML renders the Info as YAML.
+func (info Info) ToYAML() string {
+	b, _ := yaml.Marshal(info)
+
+	return string(b)
+}
+
+// Get returns the Info populated from the ldflags-injected build-time
+// variables plus the running Go toolchain and platform.
+func Get() Info {
+	return Info{
+		GitVersion:   GitVersion,
+		GitCommit:    GitCommit,
+		GitTreeState: GitTreeState,
+		BuildDate:    BuildDate,
+		GoVersion:    runtime.Version(),
+		Compiler:     runtime.Compiler,
+		Platform:     fmt.Sprintf("%s/%s", runtime.GOOS, runtime.GOARCH),
+	}
+}
@@ -0,0 +1,46 @@
+package version
+
+import (
+	"encoding/json"
+	"testing"
+
+	"gopkg.in/yaml.v2"
+)
+
+func TestInfoToJSON(t *testing.T) {
+	info := Info{GitVersion: "v1.2.3", GitCommit: "deadbeef", Platform: "linux/amd64"}
+
+	var got Info
+	if err := json.Unmarshal([]byte(info.ToJSON()), &got); err != nil {
+		t.Fatalf("ToJSON produced invalid JSON: %v", err)
+	}
+
+	if got != info {
+		t.Fatalf("ToJSON round-trip mismatch: got %+v, want %+v", got, info)
+	}
+}
+
+func TestInfoToYAML(t *testing.T) {
+	info := Info{GitVersion: "v1.2.3", GitCommit: "deadbeef", Platform: "linux/amd64"}
+
+	var got Info
+	if err := yaml.Unmarshal([]byte(info.ToYAML()), &got); err != nil {
+		t.Fatalf("ToYAML produced invalid YAML: %v", err)
+	}
+
+	if got != info {
+		t.Fatalf("ToYAML round-trip mismatch: got %+v, want %+v", got, info)
+	}
+}
+
+func TestGetUsesRuntimePlatform(t *testing.T) {
+	info := Get()
+
+	if info.GoVersion == "" {
+		t.Error("Get().GoVersion is empty")
+	}
+
+	if info.Platform == "" {
+		t.Error("Get().Platform is empty")
+	}
+}